@@ -1,8 +1,10 @@
 package urlshort
 
 import (
+	"encoding/json"
 	"net/http"
 
+	"github.com/BurntSushi/toml"
 	"gopkg.in/yaml.v2"
 )
 
@@ -63,8 +65,88 @@ func YAMLHandler(yamlBytes []byte, fallback http.Handler) (http.HandlerFunc, err
 	return MapHandler(pathsToUrls, fallback), nil
 }
 
-// A struct for parsing YAML to path/url pairs
+// JSONHandler will parse the provided JSON and then return
+// an http.HandlerFunc (which also implements http.Handler)
+// that will attempt to map any paths to their corresponding
+// URL. If the path is not provided in the JSON, then the
+// fallback http.Handler will be called instead.
+//
+// JSON is expected to be in the format:
+//
+//     [
+//       {"path": "/some-path", "url": "https://www.some-url.com/demo"}
+//     ]
+//
+// The object form is also accepted, mapping paths directly
+// to urls:
+//
+//     {"/some-path": "https://www.some-url.com/demo"}
+//
+// The only errors that can be returned all related to having
+// invalid JSON data.
+//
+// See MapHandler to create a similar http.HandlerFunc via
+// a mapping of paths to urls.
+func JSONHandler(jsonBytes []byte, fallback http.Handler) (http.HandlerFunc, error) {
+	// Try the list-of-pairs form first
+	var pairs []pathURLPair
+	if err := json.Unmarshal(jsonBytes, &pairs); err == nil {
+		pathsToUrls := make(map[string]string)
+		for _, pair := range pairs {
+			pathsToUrls[pair.Path] = pair.URL
+		}
+		return MapHandler(pathsToUrls, fallback), nil
+	}
+
+	// Fall back to the object form, path -> url
+	var pathsToUrls map[string]string
+	if err := json.Unmarshal(jsonBytes, &pathsToUrls); err != nil {
+		return nil, err
+	}
+
+	return MapHandler(pathsToUrls, fallback), nil
+}
+
+// TOMLHandler will parse the provided TOML and then return
+// an http.HandlerFunc (which also implements http.Handler)
+// that will attempt to map any paths to their corresponding
+// URL. If the path is not provided in the TOML, then the
+// fallback http.Handler will be called instead.
+//
+// TOML is expected to be in the format:
+//
+//     [[pair]]
+//     path = "/some-path"
+//     url = "https://www.some-url.com/demo"
+//
+// The only errors that can be returned all related to having
+// invalid TOML data.
+//
+// See MapHandler to create a similar http.HandlerFunc via
+// a mapping of paths to urls.
+func TOMLHandler(tomlBytes []byte, fallback http.Handler) (http.HandlerFunc, error) {
+	// Parse the TOML into this slice. TOML documents must be
+	// tables at the root, so the pairs are nested under the
+	// "pair" key.
+	var parsed struct {
+		Pairs []pathURLPair `toml:"pair"`
+	}
+	if err := toml.Unmarshal(tomlBytes, &parsed); err != nil {
+		return nil, err
+	}
+
+	// Slice of structs to a map so we can reuse the handler
+	// defined above
+	pathsToUrls := make(map[string]string)
+	for _, pair := range parsed.Pairs {
+		pathsToUrls[pair.Path] = pair.URL
+	}
+
+	return MapHandler(pathsToUrls, fallback), nil
+}
+
+// A struct for parsing YAML, JSON, and TOML to path/url pairs
 type pathURLPair struct {
-	Path string `yaml:"path"`
-	URL  string `yaml:"url"`
+	Path string `yaml:"path" json:"path" toml:"path"`
+	URL  string `yaml:"url" json:"url" toml:"url"`
 }